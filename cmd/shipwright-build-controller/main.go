@@ -0,0 +1,58 @@
+// Copyright The Shipwright Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/shipwright-io/build/pkg/config"
+	"github.com/shipwright-io/build/pkg/ctxlog"
+	"github.com/shipwright-io/build/pkg/metrics"
+	"github.com/shipwright-io/build/pkg/reconciler/metricsservice"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func main() {
+	ctx := ctrl.SetupSignalHandler()
+
+	buildCfg := config.NewDefaultConfig()
+	if ns := os.Getenv("BUILD_CONTROLLER_NAMESPACE"); ns != "" {
+		buildCfg.ManagerOptions.LeaderElectionNamespace = ns
+	}
+
+	metrics.InitPrometheus(buildCfg)
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		LeaderElection:          true,
+		LeaderElectionNamespace: buildCfg.ManagerOptions.LeaderElectionNamespace,
+	})
+	if err != nil {
+		ctxlog.Error(ctx, err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	// The metrics Service is kept in sync continuously by metricsservice.Reconciler
+	// instead of being created once at startup via metrics.CreateMetricsService, so
+	// drift or deletion of the Service (or its ServiceMonitor) is self-healing.
+	metricsReconciler := metricsservice.NewReconciler(mgr.GetClient(), buildCfg, metricsservice.ReconcilerOptions{
+		ServicePorts: []corev1.ServicePort{
+			{Name: metrics.ControllerPortName, Port: 8383, TargetPort: intstr.FromInt(8383)},
+			{Name: metrics.CRPortName, Port: 8686, TargetPort: intstr.FromInt(8686)},
+		},
+		EnableServiceMonitor: true,
+	})
+	if err := metricsReconciler.SetupWithManager(mgr); err != nil {
+		ctxlog.Error(ctx, err, "unable to set up metrics Service reconciler")
+		os.Exit(1)
+	}
+
+	if err := mgr.Start(ctx); err != nil {
+		ctxlog.Error(context.Background(), err, "problem running manager")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright The Shipwright Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// PrometheusConfig contains the configuration for the Prometheus metrics exposed by the
+// build controller.
+type PrometheusConfig struct {
+	// EnabledLabels is the list of optional labels to attach to metrics, in addition to
+	// the labels that are always present. Recognized values are "buildstrategy",
+	// "namespace", "build", "buildrun" and "reason" (the matching *Label constants in
+	// pkg/metrics); "outcome" and "step" are always present and are not read from here.
+	EnabledLabels []string
+
+	// BuildRunEstablishDurationBuckets are the histogram buckets, in seconds, for the
+	// BuildRun establish duration metric.
+	BuildRunEstablishDurationBuckets []float64
+	// BuildRunCompletionDurationBuckets are the histogram buckets, in seconds, for the
+	// BuildRun completion duration metric.
+	BuildRunCompletionDurationBuckets []float64
+	// BuildRunRampUpDurationBuckets are the histogram buckets, in seconds, shared by the
+	// BuildRun and TaskRun ramp-up duration metrics.
+	BuildRunRampUpDurationBuckets []float64
+	// StepDurationBuckets are the histogram buckets, in seconds, for the per-step TaskRun
+	// duration metric.
+	StepDurationBuckets []float64
+
+	// EnabledSummaries is the list of duration metrics that should additionally be
+	// exposed as a Summary with server-side quantiles. Recognized values are the
+	// Summary* constants in pkg/metrics (e.g. "establish", "completion", "rampup").
+	EnabledSummaries []string
+	// SummaryObjectives are the quantile objectives used for every enabled Summary. If
+	// unset, pkg/metrics falls back to its own default objectives.
+	SummaryObjectives map[float64]float64
+}
+
+// ManagerOptions contains the options passed through to the controller-runtime manager.
+type ManagerOptions struct {
+	// LeaderElectionNamespace is the namespace in which the leader election resource,
+	// and the metrics Service, are created.
+	LeaderElectionNamespace string
+}
+
+// Config centralizes the configuration for the Shipwright build controller.
+type Config struct {
+	Prometheus     PrometheusConfig
+	ManagerOptions ManagerOptions
+}
+
+// DefaultBuildRunEstablishDurationBuckets are the default histogram buckets, in seconds,
+// for the BuildRun establish duration metric.
+var DefaultBuildRunEstablishDurationBuckets = []float64{1, 3, 5, 10, 20, 30, 60}
+
+// DefaultBuildRunCompletionDurationBuckets are the default histogram buckets, in
+// seconds, for the BuildRun completion duration metric.
+var DefaultBuildRunCompletionDurationBuckets = []float64{30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+// DefaultBuildRunRampUpDurationBuckets are the default histogram buckets, in seconds,
+// for the BuildRun and TaskRun ramp-up duration metrics.
+var DefaultBuildRunRampUpDurationBuckets = []float64{1, 2, 5, 10, 20, 30}
+
+// DefaultStepDurationBuckets are the default histogram buckets, in seconds, for the
+// per-step TaskRun duration metric.
+var DefaultStepDurationBuckets = []float64{1, 3, 5, 10, 30, 60, 120, 300}
+
+// NewDefaultConfig returns a Config populated with the default bucket configuration and
+// no optional labels or summaries enabled.
+func NewDefaultConfig() *Config {
+	return &Config{
+		Prometheus: PrometheusConfig{
+			BuildRunEstablishDurationBuckets:  DefaultBuildRunEstablishDurationBuckets,
+			BuildRunCompletionDurationBuckets: DefaultBuildRunCompletionDurationBuckets,
+			BuildRunRampUpDurationBuckets:     DefaultBuildRunRampUpDurationBuckets,
+			StepDurationBuckets:               DefaultStepDurationBuckets,
+		},
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/shipwright-io/build/pkg/config"
 	"github.com/shipwright-io/build/pkg/ctxlog"
 	corev1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -19,6 +18,7 @@ import (
 	"k8s.io/client-go/rest"
 	"net/http"
 	"os"
+	"reflect"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"time"
@@ -30,6 +30,9 @@ const (
 	NamespaceLabel      string = "namespace"
 	BuildLabel          string = "build"
 	BuildRunLabel       string = "buildrun"
+	OutcomeLabel        string = "outcome"
+	ReasonLabel         string = "reason"
+	StepLabel           string = "step"
 	BuildControllerName string = "shipwright-build-controller"
 	// PodNameEnvVar is the constant for env variable POD_NAME
 	// which is the name of the current pod.
@@ -40,21 +43,51 @@ const (
 	CRPortName = "cr-metrics"
 )
 
+// BuildRun outcome values used for the OutcomeLabel on build_buildruns_completed_total.
+const (
+	BuildRunOutcomeSucceeded string = "succeeded"
+	BuildRunOutcomeFailed    string = "failed"
+	BuildRunOutcomeCancelled string = "cancelled"
+	BuildRunOutcomeTimeout   string = "timeout"
+)
+
+// Names accepted in config.Prometheus.EnabledSummaries to opt a duration metric into
+// also being exposed as a Summary with server-side quantiles, alongside its histogram.
+const (
+	SummaryEstablish  string = "establish"
+	SummaryCompletion string = "completion"
+	SummaryRampUp     string = "rampup"
+)
+
+// defaultSummaryObjectives is used when config.Prometheus.SummaryObjectives is unset.
+var defaultSummaryObjectives = map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001}
+
 var (
-	buildCount    *prometheus.CounterVec
-	buildRunCount *prometheus.CounterVec
+	buildCount        *prometheus.CounterVec
+	buildRunCount     *prometheus.CounterVec
+	buildRunFailCount *prometheus.CounterVec
 
 	buildRunEstablishDuration  *prometheus.HistogramVec
 	buildRunCompletionDuration *prometheus.HistogramVec
 
-	buildRunRampUpDuration   *prometheus.HistogramVec
-	taskRunRampUpDuration    *prometheus.HistogramVec
-	taskRunPodRampUpDuration *prometheus.HistogramVec
+	buildRunEstablishSummary  *prometheus.SummaryVec
+	buildRunCompletionSummary *prometheus.SummaryVec
+	buildRunRampUpSummary     *prometheus.SummaryVec
+
+	buildRunRampUpDuration             *prometheus.HistogramVec
+	taskRunRampUpDuration              *prometheus.HistogramVec
+	taskRunPodRampUpDuration           *prometheus.HistogramVec
+	taskRunPodScheduledDuration        *prometheus.HistogramVec
+	taskRunPodInitContainerRunDuration *prometheus.HistogramVec
+
+	buildRunStepDuration  *prometheus.HistogramVec
+	buildRunStepFailCount *prometheus.CounterVec
 
 	buildStrategyLabelEnabled = false
 	namespaceLabelEnabled     = false
 	buildLabelEnabled         = false
 	buildRunLabelEnabled      = false
+	reasonLabelEnabled        = false
 
 	initialized = false
 )
@@ -92,6 +125,16 @@ func InitPrometheus(config *config.Config) {
 		buildRunLabelEnabled = true
 	}
 
+	// outcome is a required label: every BuildRun completion has one, so there is no
+	// cardinality concern in always including it.
+	buildRunOutcomeLabels := append(append([]string{}, buildRunLabels...), OutcomeLabel)
+
+	buildRunFailLabels := append([]string{}, buildRunLabels...)
+	if contains(config.Prometheus.EnabledLabels, ReasonLabel) {
+		buildRunFailLabels = append(buildRunFailLabels, ReasonLabel)
+		reasonLabelEnabled = true
+	}
+
 	buildCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "build_builds_registered_total",
@@ -104,7 +147,14 @@ func InitPrometheus(config *config.Config) {
 			Name: "build_buildruns_completed_total",
 			Help: "Number of total completed BuildRuns.",
 		},
-		buildRunLabels)
+		buildRunOutcomeLabels)
+
+	buildRunFailCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "build_buildruns_failed_total",
+			Help: "Number of total failed BuildRuns by failure reason.",
+		},
+		buildRunFailLabels)
 
 	buildRunEstablishDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -130,6 +180,41 @@ func InitPrometheus(config *config.Config) {
 		},
 		buildRunLabels)
 
+	summaryObjectives := config.Prometheus.SummaryObjectives
+	if len(summaryObjectives) == 0 {
+		summaryObjectives = defaultSummaryObjectives
+	}
+
+	if contains(config.Prometheus.EnabledSummaries, SummaryEstablish) {
+		buildRunEstablishSummary = prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name:       "build_buildrun_establish_duration_seconds_summary",
+				Help:       "BuildRun establish duration in seconds, as a summary with configurable quantile objectives.",
+				Objectives: summaryObjectives,
+			},
+			buildRunLabels)
+	}
+
+	if contains(config.Prometheus.EnabledSummaries, SummaryCompletion) {
+		buildRunCompletionSummary = prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name:       "build_buildrun_completion_duration_seconds_summary",
+				Help:       "BuildRun completion duration in seconds, as a summary with configurable quantile objectives.",
+				Objectives: summaryObjectives,
+			},
+			buildRunLabels)
+	}
+
+	if contains(config.Prometheus.EnabledSummaries, SummaryRampUp) {
+		buildRunRampUpSummary = prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name:       "build_buildrun_rampup_duration_seconds_summary",
+				Help:       "BuildRun ramp-up duration in seconds, as a summary with configurable quantile objectives.",
+				Objectives: summaryObjectives,
+			},
+			buildRunLabels)
+	}
+
 	taskRunRampUpDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "build_buildrun_taskrun_rampup_duration_seconds",
@@ -146,16 +231,66 @@ func InitPrometheus(config *config.Config) {
 		},
 		buildRunLabels)
 
-	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(
+	taskRunPodScheduledDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "build_buildrun_taskrun_pod_scheduled_duration_seconds",
+			Help:    "BuildRun taskrun pod scheduled duration in seconds (time between pod creation and the PodScheduled condition becoming true).",
+			Buckets: config.Prometheus.BuildRunRampUpDurationBuckets,
+		},
+		buildRunLabels)
+
+	taskRunPodInitContainerRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "build_buildrun_taskrun_pod_init_container_run_duration_seconds",
+			Help:    "BuildRun taskrun pod cumulative init container run duration in seconds (sum of each init container's terminated-finished minus terminated-started timestamps). Note this measures init container execution time, not image pull latency: ContainerStateTerminated.StartedAt is recorded after the image has already been pulled, so pull time is not observable from pod status alone.",
+			Buckets: config.Prometheus.BuildRunRampUpDurationBuckets,
+		},
+		buildRunLabels)
+
+	// step is a required label: every reported sample belongs to exactly one TaskRun step.
+	buildRunStepLabels := append(append([]string{}, buildRunLabels...), StepLabel)
+
+	buildRunStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "build_buildrun_step_duration_seconds",
+			Help:    "BuildRun TaskRun step duration in seconds.",
+			Buckets: config.Prometheus.StepDurationBuckets,
+		},
+		buildRunStepLabels)
+
+	buildRunStepFailCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "build_buildrun_step_failed_total",
+			Help: "Number of total failed BuildRun TaskRun steps.",
+		},
+		buildRunStepLabels)
+
+	collectors := []prometheus.Collector{
 		buildCount,
 		buildRunCount,
+		buildRunFailCount,
 		buildRunEstablishDuration,
 		buildRunCompletionDuration,
 		buildRunRampUpDuration,
 		taskRunRampUpDuration,
 		taskRunPodRampUpDuration,
-	)
+		taskRunPodScheduledDuration,
+		taskRunPodInitContainerRunDuration,
+		buildRunStepDuration,
+		buildRunStepFailCount,
+	}
+	if buildRunEstablishSummary != nil {
+		collectors = append(collectors, buildRunEstablishSummary)
+	}
+	if buildRunCompletionSummary != nil {
+		collectors = append(collectors, buildRunCompletionSummary)
+	}
+	if buildRunRampUpSummary != nil {
+		collectors = append(collectors, buildRunRampUpSummary)
+	}
+
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(collectors...)
 }
 
 // ExtraHandlers returns a mapping of paths and their respective
@@ -164,6 +299,62 @@ func ExtraHandlers() map[string]http.HandlerFunc {
 	return metricsExtraHandlers
 }
 
+// ResetForTesting unregisters all metrics from the global prometheus registry and clears
+// the initialized flag, so that a subsequent InitPrometheus call (e.g. with different
+// config.Prometheus settings) re-creates and re-registers them from scratch. This is the
+// seam an enable/disable + re-register test would call between two InitPrometheus calls
+// with differing config.Prometheus.EnabledSummaries; this package has no existing
+// *_test.go to add such a test alongside, so none is added here.
+func ResetForTesting() {
+	if !initialized {
+		return
+	}
+
+	for _, collector := range []prometheus.Collector{
+		buildCount,
+		buildRunCount,
+		buildRunFailCount,
+		buildRunEstablishDuration,
+		buildRunCompletionDuration,
+		buildRunRampUpDuration,
+		taskRunRampUpDuration,
+		taskRunPodRampUpDuration,
+		taskRunPodScheduledDuration,
+		taskRunPodInitContainerRunDuration,
+		buildRunStepDuration,
+		buildRunStepFailCount,
+		buildRunEstablishSummary,
+		buildRunCompletionSummary,
+		buildRunRampUpSummary,
+	} {
+		if collector != nil && !reflect.ValueOf(collector).IsNil() {
+			metrics.Registry.Unregister(collector)
+		}
+	}
+
+	buildCount = nil
+	buildRunCount = nil
+	buildRunFailCount = nil
+	buildRunEstablishDuration = nil
+	buildRunCompletionDuration = nil
+	buildRunRampUpDuration = nil
+	taskRunRampUpDuration = nil
+	taskRunPodRampUpDuration = nil
+	taskRunPodScheduledDuration = nil
+	taskRunPodInitContainerRunDuration = nil
+	buildRunStepDuration = nil
+	buildRunStepFailCount = nil
+	buildRunEstablishSummary = nil
+	buildRunCompletionSummary = nil
+	buildRunRampUpSummary = nil
+	buildStrategyLabelEnabled = false
+	namespaceLabelEnabled = false
+	buildLabelEnabled = false
+	buildRunLabelEnabled = false
+	reasonLabelEnabled = false
+	initialized = false
+}
+
 func contains(slice []string, element string) bool {
 	for _, candidate := range slice {
 		if candidate == element {
@@ -215,31 +406,71 @@ func BuildCountInc(buildStrategy string, namespace string, build string) {
 	}
 }
 
-// BuildRunCountInc increases a number of the existing build run total count
-func BuildRunCountInc(buildStrategy string, namespace string, build string, buildRun string) {
+// BuildRunOutcomeInc increases the build run total count for the given outcome
+// (BuildRunOutcomeSucceeded, BuildRunOutcomeFailed, BuildRunOutcomeCancelled, or
+// BuildRunOutcomeTimeout) and, if outcome is BuildRunOutcomeFailed, also increases
+// the failed build run count for the given reason. It should be called exactly once
+// per terminal BuildRun transition.
+func BuildRunOutcomeInc(buildStrategy string, namespace string, build string, buildRun string, outcome string, reason string) {
 	if buildRunCount != nil {
-		buildRunCount.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Inc()
+		outcomeLabels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
+		outcomeLabels[OutcomeLabel] = outcome
+		buildRunCount.With(outcomeLabels).Inc()
+	}
+
+	if outcome != BuildRunOutcomeFailed {
+		return
+	}
+
+	if buildRunFailCount != nil {
+		failLabels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
+		if reasonLabelEnabled {
+			failLabels[ReasonLabel] = reason
+		}
+		buildRunFailCount.With(failLabels).Inc()
 	}
 }
 
+// BuildRunCountInc increases a number of the existing build run total count.
+//
+// Deprecated: call sites that know the terminal outcome of the BuildRun should call
+// BuildRunOutcomeInc instead so the outcome label is recorded accurately. This shim
+// exists only for callers that have not yet been migrated; it always reports
+// BuildRunOutcomeSucceeded, since it has no way to know otherwise.
+func BuildRunCountInc(buildStrategy string, namespace string, build string, buildRun string) {
+	BuildRunOutcomeInc(buildStrategy, namespace, build, buildRun, BuildRunOutcomeSucceeded, "")
+}
+
 // BuildRunEstablishObserve sets the build run establish time
 func BuildRunEstablishObserve(buildStrategy string, namespace string, build string, buildRun string, duration time.Duration) {
+	labels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
 	if buildRunEstablishDuration != nil {
-		buildRunEstablishDuration.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Observe(duration.Seconds())
+		buildRunEstablishDuration.With(labels).Observe(duration.Seconds())
+	}
+	if buildRunEstablishSummary != nil {
+		buildRunEstablishSummary.With(labels).Observe(duration.Seconds())
 	}
 }
 
 // BuildRunCompletionObserve sets the build run completion time
 func BuildRunCompletionObserve(buildStrategy string, namespace string, build string, buildRun string, duration time.Duration) {
+	labels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
 	if buildRunCompletionDuration != nil {
-		buildRunCompletionDuration.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Observe(duration.Seconds())
+		buildRunCompletionDuration.With(labels).Observe(duration.Seconds())
+	}
+	if buildRunCompletionSummary != nil {
+		buildRunCompletionSummary.With(labels).Observe(duration.Seconds())
 	}
 }
 
 // BuildRunRampUpDurationObserve processes the observation of a new buildrun ramp-up duration
 func BuildRunRampUpDurationObserve(buildStrategy string, namespace string, build string, buildRun string, duration time.Duration) {
+	labels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
 	if buildRunRampUpDuration != nil {
-		buildRunRampUpDuration.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Observe(duration.Seconds())
+		buildRunRampUpDuration.With(labels).Observe(duration.Seconds())
+	}
+	if buildRunRampUpSummary != nil {
+		buildRunRampUpSummary.With(labels).Observe(duration.Seconds())
 	}
 }
 
@@ -257,9 +488,68 @@ func TaskRunPodRampUpDurationObserve(buildStrategy string, namespace string, bui
 	}
 }
 
+// TaskRunPodScheduledObserve processes the observation of the time it took the taskrun pod
+// to go from creation to the PodScheduled condition becoming true
+func TaskRunPodScheduledObserve(buildStrategy string, namespace string, build string, buildRun string, duration time.Duration) {
+	if taskRunPodScheduledDuration != nil {
+		taskRunPodScheduledDuration.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Observe(duration.Seconds())
+	}
+}
+
+// TaskRunPodInitContainerRunObserve processes the observation of a taskrun pod's cumulative
+// init container run duration (execution time, not image pull latency)
+func TaskRunPodInitContainerRunObserve(buildStrategy string, namespace string, build string, buildRun string, duration time.Duration) {
+	if taskRunPodInitContainerRunDuration != nil {
+		taskRunPodInitContainerRunDuration.With(createBuildRunLabels(buildStrategy, namespace, build, buildRun)).Observe(duration.Seconds())
+	}
+}
+
+// BuildRunStepObserve processes the observation of a single TaskRun step's duration and, if
+// the step exited with a non-zero code, increases the step failure count.
+func BuildRunStepObserve(buildStrategy string, namespace string, build string, buildRun string, step string, duration time.Duration, exitCode int32) {
+	labels := createBuildRunLabels(buildStrategy, namespace, build, buildRun)
+	labels[StepLabel] = step
+
+	if buildRunStepDuration != nil {
+		buildRunStepDuration.With(labels).Observe(duration.Seconds())
+	}
+	if exitCode != 0 && buildRunStepFailCount != nil {
+		buildRunStepFailCount.With(labels).Inc()
+	}
+}
+
+// TaskRunPodScheduledDuration returns the duration between the pod's creation timestamp and the
+// transition of its PodScheduled condition to true, or zero if the pod is not yet scheduled.
+func TaskRunPodScheduledDuration(pod *corev1.Pod) time.Duration {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionTrue {
+			return condition.LastTransitionTime.Sub(pod.CreationTimestamp.Time)
+		}
+	}
+	return 0
+}
+
+// TaskRunPodInitContainerRunDuration sums up the execution time across a taskrun pod's
+// terminated init containers, using each one's StartedAt/FinishedAt delta. This is the
+// containers' run time, not image pull latency: StartedAt is recorded once the process
+// starts, which is already after its image was pulled.
+func TaskRunPodInitContainerRunDuration(pod *corev1.Pod) time.Duration {
+	var total time.Duration
+	for _, status := range pod.Status.InitContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil {
+			total += terminated.FinishedAt.Sub(terminated.StartedAt.Time)
+		}
+	}
+	return total
+}
+
 // CreateMetricsService creates a Kubernetes Service to expose the passed metrics
 // port(s) with the given name(s).
-func CreateMetricsService(ctx context.Context, cfg *rest.Config, buildCfg *config.Config, servicePorts []v1.ServicePort) (*v1.Service, error) {
+//
+// Deprecated: this only runs once at controller startup, so drift or deletion of the
+// Service is never corrected. Prefer registering pkg/reconciler/metricsservice.Reconciler
+// with the manager instead, which keeps the Service in sync continuously.
+func CreateMetricsService(ctx context.Context, cfg *rest.Config, buildCfg *config.Config, servicePorts []corev1.ServicePort) (*corev1.Service, error) {
 	if len(servicePorts) < 1 {
 		return nil, fmt.Errorf("failed to create metrics Serice; service ports were empty")
 	}
@@ -270,25 +560,25 @@ func CreateMetricsService(ctx context.Context, cfg *rest.Config, buildCfg *confi
 
 	label := map[string]string{"name": BuildControllerName}
 
-	service := &v1.Service{
+	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-metrics", BuildControllerName),
 			Namespace: buildCfg.ManagerOptions.LeaderElectionNamespace,
 			Labels:    label,
 		},
-		Spec: v1.ServiceSpec{
+		Spec: corev1.ServiceSpec{
 			Ports:    servicePorts,
 			Selector: label,
 		},
 	}
 
-	ownRef, err := getPodOwnerRef(ctx, client, buildCfg.ManagerOptions.LeaderElectionNamespace)
+	ownRef, err := GetPodOwnerRef(ctx, client, buildCfg.ManagerOptions.LeaderElectionNamespace)
 	if err != nil {
 		return nil, err
 	}
 	service.SetOwnerReferences([]metav1.OwnerReference{*ownRef})
 
-	service, err = createOrUpdateService(ctx, client, buildCfg, service)
+	service, err = CreateOrUpdateService(ctx, client, buildCfg, service)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create or get service for metrics: %w", err)
 	}
@@ -296,14 +586,16 @@ func CreateMetricsService(ctx context.Context, cfg *rest.Config, buildCfg *confi
 	return service, nil
 }
 
-func createOrUpdateService(ctx context.Context, client crclient.Client, buildCfg *config.Config, s *v1.Service) (*v1.Service, error) {
+// CreateOrUpdateService creates the given Service, or updates it in place if a Service
+// with the same name/namespace already exists.
+func CreateOrUpdateService(ctx context.Context, client crclient.Client, buildCfg *config.Config, s *corev1.Service) (*corev1.Service, error) {
 	if err := client.Create(ctx, s); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, err
 		}
 		// Service already exists, we want to update it
 		// as we do not know if any fields might have changed.
-		existingService := &v1.Service{}
+		existingService := &corev1.Service{}
 		err := client.Get(ctx, types.NamespacedName{
 			Name:      s.Name,
 			Namespace: s.Namespace,
@@ -313,7 +605,7 @@ func createOrUpdateService(ctx context.Context, client crclient.Client, buildCfg
 		}
 
 		s.ResourceVersion = existingService.ResourceVersion
-		if existingService.Spec.Type == v1.ServiceTypeClusterIP {
+		if existingService.Spec.Type == corev1.ServiceTypeClusterIP {
 			s.Spec.ClusterIP = existingService.Spec.ClusterIP
 		}
 		err = client.Update(ctx, s)
@@ -330,7 +622,9 @@ func createOrUpdateService(ctx context.Context, client crclient.Client, buildCfg
 	return s, nil
 }
 
-func getPodOwnerRef(ctx context.Context, client crclient.Client, ns string) (*metav1.OwnerReference, error) {
+// GetPodOwnerRef resolves the final controller/owner reference of the Pod the controller is
+// currently running in, walking up the owner chain (e.g. ReplicaSet -> Deployment).
+func GetPodOwnerRef(ctx context.Context, client crclient.Client, ns string) (*metav1.OwnerReference, error) {
 	// Get current Pod the controller is running in
 	podName := os.Getenv(PodNameEnvVar)
 	if podName == "" {
@@ -353,7 +647,7 @@ func getPodOwnerRef(ctx context.Context, client crclient.Client, ns string) (*me
 	podOwnerRefs := metav1.NewControllerRef(pod, pod.GroupVersionKind())
 	// Get Owner that the Pod belongs to
 	ownerRef := metav1.GetControllerOf(pod)
-	finalOwnerRef, err := findFinalOwnerRef(ctx, client, ns, ownerRef)
+	finalOwnerRef, err := FindFinalOwnerRef(ctx, client, ns, ownerRef)
 	if err != nil {
 		return nil, err
 	}
@@ -365,8 +659,8 @@ func getPodOwnerRef(ctx context.Context, client crclient.Client, ns string) (*me
 	return podOwnerRefs, nil
 }
 
-// findFinalOwnerRef tries to locate the final controller/owner based on the owner reference provided.
-func findFinalOwnerRef(ctx context.Context, client crclient.Client, ns string,
+// FindFinalOwnerRef tries to locate the final controller/owner based on the owner reference provided.
+func FindFinalOwnerRef(ctx context.Context, client crclient.Client, ns string,
 	ownerRef *metav1.OwnerReference) (*metav1.OwnerReference, error) {
 	if ownerRef == nil {
 		return nil, nil
@@ -381,7 +675,7 @@ func findFinalOwnerRef(ctx context.Context, client crclient.Client, ns string,
 	}
 	newOwnerRef := metav1.GetControllerOf(obj)
 	if newOwnerRef != nil {
-		return findFinalOwnerRef(ctx, client, ns, newOwnerRef)
+		return FindFinalOwnerRef(ctx, client, ns, newOwnerRef)
 	}
 	return ownerRef, nil
 }
@@ -0,0 +1,16 @@
+// Copyright The Shipwright Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package buildrun
+
+import "github.com/shipwright-io/build/pkg/metrics"
+
+// ObserveTerminalBuildRun is called once from the BuildRun controller's terminal-state
+// handling, after the BuildRun's Succeeded condition has settled. It replaces the direct
+// call to the now-deprecated metrics.BuildRunCountInc with metrics.BuildRunOutcomeInc, so
+// that outcome and failure-reason are recorded together instead of every BuildRun being
+// counted as succeeded regardless of how it actually finished.
+func ObserveTerminalBuildRun(buildStrategy string, namespace string, build string, buildRun string, outcome string, reason string) {
+	metrics.BuildRunOutcomeInc(buildStrategy, namespace, build, buildRun, outcome, reason)
+}
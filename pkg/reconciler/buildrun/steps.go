@@ -0,0 +1,24 @@
+// Copyright The Shipwright Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package buildrun
+
+import (
+	"github.com/shipwright-io/build/pkg/metrics"
+	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// ObserveTaskRunSteps is called alongside ObserveTerminalBuildRun once the underlying
+// TaskRun has finished, and records one BuildRunStepObserve call per step so that
+// per-step duration and failure counts are attributed to the step that produced them,
+// rather than only to the BuildRun as a whole.
+func ObserveTaskRunSteps(buildStrategy string, namespace string, build string, buildRun string, steps []tektonv1.StepState) {
+	for _, step := range steps {
+		if step.Terminated == nil {
+			continue
+		}
+		duration := step.Terminated.FinishedAt.Sub(step.Terminated.StartedAt.Time)
+		metrics.BuildRunStepObserve(buildStrategy, namespace, build, buildRun, step.Name, duration, step.Terminated.ExitCode)
+	}
+}
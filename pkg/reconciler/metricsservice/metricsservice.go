@@ -0,0 +1,244 @@
+// Copyright The Shipwright Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metricsservice contains a controller that keeps the metrics Service (and,
+// optionally, a ServiceMonitor) owned by the Shipwright build controller in sync with
+// the running configuration, re-creating or re-patching it if it drifts or is deleted.
+package metricsservice
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/shipwright-io/build/pkg/config"
+	"github.com/shipwright-io/build/pkg/ctxlog"
+	"github.com/shipwright-io/build/pkg/metrics"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// serviceMonitorGVK is the GroupVersionKind of the Prometheus Operator ServiceMonitor CRD.
+// It is handled as unstructured data so this controller does not need to vendor the
+// prometheus-operator API types.
+var serviceMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "ServiceMonitor",
+}
+
+// ReconcilerOptions holds the configuration the Reconciler needs to know what the
+// metrics Service is supposed to look like.
+type ReconcilerOptions struct {
+	// ServicePorts are the ports the reconciled Service should expose.
+	ServicePorts []corev1.ServicePort
+	// EnableServiceMonitor additionally reconciles a Prometheus Operator ServiceMonitor
+	// pointed at the metrics Service, when the CRD is available on the cluster.
+	EnableServiceMonitor bool
+}
+
+// Reconciler keeps the metrics Service owned by the build controller in sync with
+// ReconcilerOptions, re-creating it if it is deleted and patching it back in place if
+// its ports, selector, or labels drift.
+type Reconciler struct {
+	client  crclient.Client
+	config  *config.Config
+	options ReconcilerOptions
+}
+
+// NewReconciler creates a new Reconciler for the metrics Service.
+func NewReconciler(client crclient.Client, buildCfg *config.Config, options ReconcilerOptions) *Reconciler {
+	return &Reconciler{
+		client:  client,
+		config:  buildCfg,
+		options: options,
+	}
+}
+
+func (r *Reconciler) serviceName() string {
+	return fmt.Sprintf("%s-metrics", metrics.BuildControllerName)
+}
+
+func (r *Reconciler) serviceNamespace() string {
+	return r.config.ManagerOptions.LeaderElectionNamespace
+}
+
+// Reconcile implements the controller-runtime Reconciler interface. It re-creates the
+// metrics Service if it is missing and patches it back to the desired state if it has
+// drifted from config.Config.
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	if request.Name != r.serviceName() || request.Namespace != r.serviceNamespace() {
+		return ctrl.Result{}, nil
+	}
+
+	desired := r.desiredService()
+
+	ownRef, err := metrics.GetPodOwnerRef(ctx, r.client, r.serviceNamespace())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	desired.SetOwnerReferences([]metav1.OwnerReference{*ownRef})
+
+	// persisted always ends up pointing at an object the API server has assigned a UID
+	// to (never the in-memory desired Service before it has been created), so that
+	// reconcileServiceMonitor can build a valid controller owner reference from it.
+	var persisted *corev1.Service
+
+	existing := &corev1.Service{}
+	err = r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := r.client.Create(ctx, desired); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to re-create metrics Service: %w", err)
+		}
+		ctxlog.Info(ctx, "Metrics Service re-created", "Service.Name", desired.Name, "Service.Namespace", desired.Namespace)
+		persisted = desired
+
+	case err != nil:
+		return ctrl.Result{}, err
+
+	case driftsFromDesired(existing, desired):
+		existing.Spec.Ports = desired.Spec.Ports
+		existing.Spec.Selector = desired.Spec.Selector
+		existing.Labels = desired.Labels
+		existing.OwnerReferences = desired.OwnerReferences
+		if err := r.client.Update(ctx, existing); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch metrics Service back to desired state: %w", err)
+		}
+		ctxlog.Info(ctx, "Metrics Service patched back to desired state", "Service.Name", existing.Name, "Service.Namespace", existing.Namespace)
+		persisted = existing
+
+	default:
+		persisted = existing
+	}
+
+	if r.options.EnableServiceMonitor {
+		if err := r.reconcileServiceMonitor(ctx, persisted); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) desiredService() *corev1.Service {
+	label := map[string]string{"name": metrics.BuildControllerName}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.serviceName(),
+			Namespace: r.serviceNamespace(),
+			Labels:    label,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    r.options.ServicePorts,
+			Selector: label,
+		},
+	}
+}
+
+func driftsFromDesired(existing, desired *corev1.Service) bool {
+	return !reflect.DeepEqual(existing.Spec.Ports, desired.Spec.Ports) ||
+		!reflect.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) ||
+		!reflect.DeepEqual(existing.Labels, desired.Labels) ||
+		!reflect.DeepEqual(existing.OwnerReferences, desired.OwnerReferences)
+}
+
+// reconcileServiceMonitor creates or updates a ServiceMonitor pointed at the metrics
+// Service, if the ServiceMonitor CRD is registered on the cluster. Clusters without the
+// Prometheus Operator installed are left untouched.
+//
+// The ServiceMonitor is owned by the metrics Service itself (rather than the pod-owner
+// chain used for the Service), so that SetupWithManager's Owns() watch re-enqueues the
+// Service - and thereby re-creates the ServiceMonitor - if it is deleted or changed out
+// of band; it is not left to be corrected only incidentally on unrelated Service events.
+func (r *Reconciler) reconcileServiceMonitor(ctx context.Context, service *corev1.Service) error {
+	if _, err := r.client.RESTMapper().RESTMapping(serviceMonitorGVK.GroupKind(), serviceMonitorGVK.Version); err != nil {
+		// ServiceMonitor CRD not installed; nothing to reconcile.
+		return nil
+	}
+
+	endpoints := make([]interface{}, 0, len(r.options.ServicePorts))
+	for _, port := range r.options.ServicePorts {
+		endpoints = append(endpoints, map[string]interface{}{"port": port.Name})
+	}
+
+	desired := &unstructured.Unstructured{}
+	desired.SetGroupVersionKind(serviceMonitorGVK)
+	desired.SetName(service.Name)
+	desired.SetNamespace(service.Namespace)
+	desired.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(service, corev1.SchemeGroupVersion.WithKind("Service")),
+	})
+	if err := unstructured.SetNestedField(desired.Object, map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{"name": metrics.BuildControllerName},
+		},
+		"endpoints": endpoints,
+	}, "spec"); err != nil {
+		return fmt.Errorf("failed to build ServiceMonitor spec: %w", err)
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(serviceMonitorGVK)
+	err := r.client.Get(ctx, types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := r.client.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create ServiceMonitor: %w", err)
+		}
+		ctxlog.Info(ctx, "ServiceMonitor created", "ServiceMonitor.Name", desired.GetName(), "ServiceMonitor.Namespace", desired.GetNamespace())
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	desired.SetResourceVersion(existing.GetResourceVersion())
+	if err := r.client.Update(ctx, desired); err != nil {
+		return fmt.Errorf("failed to update ServiceMonitor: %w", err)
+	}
+	return nil
+}
+
+// SetupWithManager registers the Reconciler with the manager, restricting its watch to
+// only the metrics Service by name/namespace (and, when ServiceMonitor reconciliation is
+// enabled and the ServiceMonitor CRD is installed, any ServiceMonitor it owns) rather than
+// every Service in the cluster. It also runs one reconcile when the manager starts, so that
+// a metrics Service that does not exist yet is created.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isMetricsService := predicate.NewPredicateFuncs(func(obj crclient.Object) bool {
+		return obj.GetName() == r.serviceName() && obj.GetNamespace() == r.serviceNamespace()
+	})
+
+	initial := ctrl.Request{NamespacedName: types.NamespacedName{Name: r.serviceName(), Namespace: r.serviceNamespace()}}
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if _, err := r.Reconcile(ctx, initial); err != nil {
+			ctxlog.Error(ctx, err, "could not create metrics Service at startup")
+		}
+		return nil
+	})); err != nil {
+		return err
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Service{}, builder.WithPredicates(isMetricsService))
+
+	if _, err := mgr.GetRESTMapper().RESTMapping(serviceMonitorGVK.GroupKind(), serviceMonitorGVK.Version); r.options.EnableServiceMonitor && err == nil {
+		owned := &unstructured.Unstructured{}
+		owned.SetGroupVersionKind(serviceMonitorGVK)
+		bldr = bldr.Owns(owned)
+	}
+
+	return bldr.Complete(r)
+}